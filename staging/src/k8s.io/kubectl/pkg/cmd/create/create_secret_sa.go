@@ -18,15 +18,29 @@ package create
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	authenticationv1 "k8s.io/api/authentication/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/cli-runtime/pkg/resource"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	watchtools "k8s.io/client-go/tools/watch"
 	cmdutil "k8s.io/kubectl/pkg/cmd/util"
 	"k8s.io/kubectl/pkg/scheme"
 	"k8s.io/kubectl/pkg/util"
@@ -36,11 +50,20 @@ import (
 
 var (
 	secretForSaLong = templates.LongDesc(i18n.T(`
-		Create a new secret for use in Service Accounts as a token.`))
+		Create a new secret for use in Service Accounts as a token.
+
+		Pass --use-token-request to mint a bound token through the TokenRequest API
+		instead of creating a legacy ServiceAccount token secret.`))
 
 	secretForSaExample = templates.Examples(i18n.T(`
 		  # If you don't already have a .dockercfg file, you can create a dockercfg secret directly by using:
-		  kubectl create secret token-sa my-secret --serviceaccount=serviceaccount`))
+		  kubectl create secret token-sa my-secret --serviceaccount=serviceaccount
+
+		  # Request a bound, time-limited token via the TokenRequest API
+		  kubectl create secret token-sa my-secret --serviceaccount=serviceaccount --use-token-request --audience=https://kubernetes.default.svc --duration=1h
+
+		  # Mint a token and write a ready-to-use kubeconfig for it
+		  kubectl create secret token-sa my-secret --serviceaccount=serviceaccount --use-token-request --output-kubeconfig=./sa.kubeconfig`))
 )
 
 // CreateSecretTokenSaOptions holds the options for 'create secret docker-registry' sub command
@@ -54,6 +77,42 @@ type CreateSecretTokenSaOptions struct {
 	// Service Account for token (required)
 	ServiceAccount string
 
+	// UseTokenRequest requests a bound token via the TokenRequest API instead of
+	// creating a legacy SecretTypeServiceAccountToken secret.
+	UseTokenRequest bool
+	Audiences       []string
+	Duration        time.Duration
+	BoundObjectKind string
+	BoundObjectName string
+	BoundObjectUID  string
+
+	// Rotate creates a uniquely-named secret (via GenerateName) on every
+	// invocation and patches the ServiceAccount to reference it, instead of
+	// creating a secret with the fixed name Name.
+	Rotate   bool
+	KeepLast int
+
+	// IfNotExists makes the command idempotent: if a token secret already
+	// exists for ServiceAccount, the newest one is printed instead of creating a
+	// new one. If its token data is not populated yet, it is waited on when Wait
+	// is set and printed as is otherwise.
+	IfNotExists bool
+
+	// Wait blocks until the controller populates the secret's token data, since
+	// legacy SecretTypeServiceAccountToken secrets start out empty.
+	Wait    bool
+	Timeout time.Duration
+
+	// OutputKubeconfig, when set, writes a ready-to-use kubeconfig pointing at
+	// the current cluster and authenticating with the minted token. Only used
+	// with --use-token-request.
+	OutputKubeconfig string
+	RESTConfig       *rest.Config
+
+	// OutputFormat is the raw -o/--output value, captured so Validate can
+	// reject formats that only make sense for a subset of modes.
+	OutputFormat string
+
 	FieldManager     string
 	CreateAnnotation bool
 	Namespace        string
@@ -71,9 +130,10 @@ type CreateSecretTokenSaOptions struct {
 // NewSecretDockerRegistryOptions creates a new *CreateSecretTokenSaOptions with default value
 func NewSecretSaOptions(ioStreams genericclioptions.IOStreams) *CreateSecretTokenSaOptions {
 	return &CreateSecretTokenSaOptions{
-		ServiceAccount:     "test-sa",
-		PrintFlags: genericclioptions.NewPrintFlags("created").WithTypeSetter(scheme.Scheme),
-		IOStreams:  ioStreams,
+		ServiceAccount: "test-sa",
+		Timeout:        30 * time.Second,
+		PrintFlags:     genericclioptions.NewPrintFlags("created").WithTypeSetter(scheme.Scheme),
+		IOStreams:      ioStreams,
 	}
 }
 
@@ -103,6 +163,24 @@ func NewCmdCreateSa(f cmdutil.Factory, ioStreams genericclioptions.IOStreams) *c
 	cmd.Flags().StringVar(&o.ServiceAccount, "serviceaccount", o.ServiceAccount, i18n.T("ServiceAccount that will create token"))
 	cmdutil.AddFieldManagerFlagVar(cmd, &o.FieldManager, "kubectl-create")
 
+	cmd.Flags().BoolVar(&o.UseTokenRequest, "use-token-request", o.UseTokenRequest, i18n.T("Request a bound token via the TokenRequest API instead of creating a legacy service account token secret"))
+	cmd.Flags().StringArrayVar(&o.Audiences, "audience", o.Audiences, i18n.T("Intended audience of the token, repeatable; defaults to the API server audience. Only used with --use-token-request"))
+	cmd.Flags().DurationVar(&o.Duration, "duration", o.Duration, i18n.T("Requested validity duration of the token. Only used with --use-token-request"))
+	cmd.Flags().StringVar(&o.BoundObjectKind, "bound-object-kind", o.BoundObjectKind, i18n.T("Kind of the object the token is bound to, e.g. Pod or Secret. Only used with --use-token-request"))
+	cmd.Flags().StringVar(&o.BoundObjectName, "bound-object-name", o.BoundObjectName, i18n.T("Name of the object the token is bound to. Only used with --use-token-request"))
+	cmd.Flags().StringVar(&o.BoundObjectUID, "bound-object-uid", o.BoundObjectUID, i18n.T("UID of the object the token is bound to. Only used with --use-token-request"))
+
+	cmd.Flags().BoolVar(&o.Rotate, "rotate", o.Rotate, i18n.T("Create a uniquely-named secret and patch the ServiceAccount to reference it, instead of creating a secret named NAME"))
+	cmd.Flags().IntVar(&o.KeepLast, "keep-last", o.KeepLast, i18n.T("When used with --rotate, delete the ServiceAccount's older token secrets beyond the N most recent. 0 disables pruning"))
+
+	cmd.Flags().BoolVar(&o.IfNotExists, "if-not-exists", o.IfNotExists, i18n.T("Reuse the newest existing token secret for the ServiceAccount instead of creating a new one. If its token data is not populated yet, it is printed as is unless --wait is set"))
+
+	cmd.Flags().BoolVar(&o.Wait, "wait", o.Wait, i18n.T("Wait for the controller to populate the secret's token data before printing it"))
+	cmd.Flags().DurationVar(&o.Timeout, "timeout", o.Timeout, i18n.T("How long to wait for the secret's token data to be populated. Only used with --wait"))
+
+	cmd.Flags().StringArrayVar(&o.Annotations, "annotation", o.Annotations, i18n.T("Additional annotation as key=value, repeatable. Merged into the secret's annotations, e.g. to set kubernetes.io/enforce-mountable-secrets"))
+	cmd.Flags().StringVar(&o.OutputKubeconfig, "output-kubeconfig", o.OutputKubeconfig, i18n.T("Write a kubeconfig using the minted token to this path. Only used with --use-token-request"))
+
 	return cmd
 }
 
@@ -118,6 +196,7 @@ func (o *CreateSecretTokenSaOptions) Complete(f cmdutil.Factory, cmd *cobra.Comm
 	if err != nil {
 		return err
 	}
+	o.RESTConfig = restConfig
 
 	o.Client, err = corev1client.NewForConfig(restConfig)
 	if err != nil {
@@ -148,14 +227,26 @@ func (o *CreateSecretTokenSaOptions) Complete(f cmdutil.Factory, cmd *cobra.Comm
 		return err
 	}
 
-	cmdutil.PrintFlagsWithDryRunStrategy(o.PrintFlags, o.DryRunStrategy)
-	printer, err := o.PrintFlags.ToPrinter()
-	if err != nil {
-		return err
-	}
+	outputFormat := cmdutil.GetFlagString(cmd, "output")
+	o.OutputFormat = outputFormat
+	if outputFormat == "token" {
+		o.PrintObj = func(obj runtime.Object) error {
+			return printTokenRequestToken(obj, o.Out)
+		}
+	} else if outputFormat == "" && o.UseTokenRequest {
+		o.PrintObj = func(obj runtime.Object) error {
+			return printTokenRequestDefault(obj, o.Out)
+		}
+	} else {
+		cmdutil.PrintFlagsWithDryRunStrategy(o.PrintFlags, o.DryRunStrategy)
+		printer, err := o.PrintFlags.ToPrinter()
+		if err != nil {
+			return err
+		}
 
-	o.PrintObj = func(obj runtime.Object) error {
-		return printer.PrintObj(obj, o.Out)
+		o.PrintObj = func(obj runtime.Object) error {
+			return printer.PrintObj(obj, o.Out)
+		}
 	}
 
 	o.ValidationDirective, err = cmdutil.GetValidationDirective(cmd)
@@ -174,12 +265,61 @@ func (o *CreateSecretTokenSaOptions) Validate() error {
 	if (len(o.ServiceAccount) == 0) {
 		return fmt.Errorf("either --serviceaccount is required")
 	}
+	if len(o.BoundObjectName) > 0 && len(o.BoundObjectKind) == 0 {
+		return fmt.Errorf("--bound-object-kind is required when --bound-object-name is set")
+	}
+	if o.Rotate && o.DryRunStrategy == cmdutil.DryRunClient {
+		return fmt.Errorf("--rotate cannot be used with --dry-run=client: the generated secret name only exists once the server assigns it")
+	}
+	if o.OutputFormat == "token" && !o.UseTokenRequest {
+		return fmt.Errorf("-o token requires --use-token-request: the legacy secret path has no TokenRequest to print a raw token from")
+	}
+	if o.UseTokenRequest {
+		if o.Rotate {
+			return fmt.Errorf("--rotate cannot be used with --use-token-request: TokenRequest tokens are minted fresh on every call and never need rotating")
+		}
+		if o.Wait {
+			return fmt.Errorf("--wait cannot be used with --use-token-request: the token is returned synchronously, there is nothing to wait for")
+		}
+		if o.IfNotExists {
+			return fmt.Errorf("--if-not-exists cannot be used with --use-token-request: every call mints a new token, there is no existing object to reuse")
+		}
+		if o.KeepLast > 0 {
+			return fmt.Errorf("--keep-last cannot be used with --use-token-request: it only prunes rotated legacy secrets")
+		}
+	}
+	if o.OutputKubeconfig != "" && !o.UseTokenRequest {
+		return fmt.Errorf("--output-kubeconfig requires --use-token-request: the legacy secret path has no token to put in a kubeconfig")
+	}
 	return nil
 }
 
 // Run calls createSecretDockerRegistry which will create secretDockerRegistry based on CreateSecretTokenSaOptions
 // and makes an API call to the server
 func (o *CreateSecretTokenSaOptions) Run() error {
+	if o.UseTokenRequest {
+		return o.runTokenRequest()
+	}
+
+	if o.IfNotExists {
+		existing, err := o.findExistingTokenSecret()
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			if len(existing.Data["token"]) > 0 {
+				return o.PrintObj(existing)
+			}
+			if o.Wait {
+				existing, err = o.waitForToken(existing.Name)
+				if err != nil {
+					return err
+				}
+			}
+			return o.PrintObj(existing)
+		}
+	}
+
 	secretSa, err := o.createSecretSa()
 	if err != nil {
 		return err
@@ -205,11 +345,143 @@ func (o *CreateSecretTokenSaOptions) Run() error {
 		if err != nil {
 			return fmt.Errorf("failed to create secret %v", err)
 		}
+
+		if o.Rotate && o.DryRunStrategy == cmdutil.DryRunNone {
+			if err := o.addSecretToServiceAccount(secretSa.Name); err != nil {
+				return err
+			}
+			if o.KeepLast > 0 {
+				if err := o.pruneOldSecrets(secretSa.Name); err != nil {
+					return err
+				}
+			}
+		}
+
+		if o.Wait && o.DryRunStrategy == cmdutil.DryRunNone {
+			secretSa, err = o.waitForToken(secretSa.Name)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	return o.PrintObj(secretSa)
 }
 
+// addSecretToServiceAccount patches the ServiceAccount so that secretName is
+// appended to serviceAccount.Secrets, keeping older kubelet/controller code
+// paths that look up tokens via the ServiceAccount working.
+func (o *CreateSecretTokenSaOptions) addSecretToServiceAccount(secretName string) error {
+	patch, err := json.Marshal(corev1.ServiceAccount{
+		Secrets: []corev1.ObjectReference{{Name: secretName}},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = o.Client.ServiceAccounts(o.Namespace).Patch(context.TODO(), o.ServiceAccount, types.StrategicMergePatchType, patch, metav1.PatchOptions{FieldManager: o.FieldManager})
+	if err != nil {
+		return fmt.Errorf("failed to patch service account %q with new secret %q: %v", o.ServiceAccount, secretName, err)
+	}
+	return nil
+}
+
+// pruneOldSecrets deletes token secrets owned by o.ServiceAccount beyond the
+// o.KeepLast most recent, excluding keepName (the secret just created).
+func (o *CreateSecretTokenSaOptions) pruneOldSecrets(keepName string) error {
+	secrets, err := o.Client.Secrets(o.Namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list existing token secrets for service account %q: %v", o.ServiceAccount, err)
+	}
+
+	var owned []corev1.Secret
+	for _, secret := range secrets.Items {
+		if secret.Type == corev1.SecretTypeServiceAccountToken && secret.Annotations["kubernetes.io/service-account.name"] == o.ServiceAccount && secret.Name != keepName {
+			owned = append(owned, secret)
+		}
+	}
+	sort.Slice(owned, func(i, j int) bool {
+		return owned[i].CreationTimestamp.After(owned[j].CreationTimestamp.Time)
+	})
+
+	keepOlder := o.KeepLast - 1
+	if keepOlder < 0 {
+		keepOlder = 0
+	}
+	for _, secret := range owned[minInt(keepOlder, len(owned)):] {
+		if err := o.Client.Secrets(o.Namespace).Delete(context.TODO(), secret.Name, metav1.DeleteOptions{}); err != nil {
+			return fmt.Errorf("failed to delete old token secret %q: %v", secret.Name, err)
+		}
+	}
+	return nil
+}
+
+// findExistingTokenSecret looks for an existing token secret for
+// o.ServiceAccount so --if-not-exists can reuse it instead of creating a new
+// one. It returns nil if none is found; the caller decides what to do if the
+// secret it finds hasn't had its token data populated yet.
+func (o *CreateSecretTokenSaOptions) findExistingTokenSecret() (*corev1.Secret, error) {
+	secrets, err := o.Client.Secrets(o.Namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list existing token secrets for service account %q: %v", o.ServiceAccount, err)
+	}
+
+	var newest *corev1.Secret
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+		if secret.Type != corev1.SecretTypeServiceAccountToken || secret.Annotations["kubernetes.io/service-account.name"] != o.ServiceAccount {
+			continue
+		}
+		if newest == nil || secret.CreationTimestamp.After(newest.CreationTimestamp.Time) {
+			newest = secret
+		}
+	}
+	return newest, nil
+}
+
+// waitForToken blocks, up to o.Timeout, until the named secret's token data
+// has been populated by kube-controller-manager, then returns it.
+func (o *CreateSecretTokenSaOptions) waitForToken(name string) (*corev1.Secret, error) {
+	fieldSelector := fields.OneTermEqualSelector("metadata.name", name).String()
+	lw := &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			options.FieldSelector = fieldSelector
+			return o.Client.Secrets(o.Namespace).List(context.TODO(), options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.FieldSelector = fieldSelector
+			return o.Client.Secrets(o.Namespace).Watch(context.TODO(), options)
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.TODO(), o.Timeout)
+	defer cancel()
+
+	var populated *corev1.Secret
+	_, err := watchtools.UntilWithSync(ctx, lw, &corev1.Secret{}, nil, func(event watch.Event) (bool, error) {
+		secret, ok := event.Object.(*corev1.Secret)
+		if !ok {
+			return false, fmt.Errorf("unexpected object type %T", event.Object)
+		}
+		if len(secret.Data["token"]) == 0 {
+			return false, nil
+		}
+		populated = secret
+		return true, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("timed out waiting for token secret %q to be populated: %v; if this cluster has LegacyServiceAccountTokenNoAutoGeneration enabled (Kubernetes 1.24+), legacy secrets are no longer auto-populated — use --use-token-request instead", name, err)
+	}
+
+	return populated, nil
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
 // createSecretDockerRegistry fills in key value pair from the information given in
 // CreateSecretDockerRegistryOptions into *corev1.Secret
 func (o *CreateSecretTokenSaOptions) createSecretSa() (*corev1.Secret, error) {
@@ -217,14 +489,153 @@ func (o *CreateSecretTokenSaOptions) createSecretSa() (*corev1.Secret, error) {
 	if o.EnforceNamespace {
 		namespace = o.Namespace
 	}
-	annotations := o.buildAnnotations()
-	secretSa := newSecretObjToken(o.Name, namespace, corev1.SecretTypeServiceAccountToken, annotations)
+	annotations, err := o.buildAnnotations()
+	if err != nil {
+		return nil, err
+	}
+	name := o.Name
+	if o.Rotate {
+		name = ""
+	}
+	secretSa := newSecretObjToken(name, namespace, corev1.SecretTypeServiceAccountToken, annotations)
+	if o.Rotate {
+		secretSa.GenerateName = o.Name + "-"
+	}
 
 	return secretSa, nil
 }
 
-func (o *CreateSecretTokenSaOptions) buildAnnotations() map[string]string {
+func (o *CreateSecretTokenSaOptions) buildAnnotations() (map[string]string, error) {
 	var annotations = make(map[string]string)
+	for _, annotation := range o.Annotations {
+		key, value, found := strings.Cut(annotation, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid --annotation %q: expected key=value", annotation)
+		}
+		annotations[key] = value
+	}
     annotations["kubernetes.io/service-account.name"] = o.ServiceAccount
-	return annotations
-}
\ No newline at end of file
+	return annotations, nil
+}
+
+// runTokenRequest mints a bound token for the service account via the
+// serviceaccounts/token subresource instead of creating a legacy secret.
+func (o *CreateSecretTokenSaOptions) runTokenRequest() error {
+	tokenRequest := &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			Audiences: o.Audiences,
+		},
+	}
+	if o.Duration > 0 {
+		expirationSeconds := int64(o.Duration.Seconds())
+		tokenRequest.Spec.ExpirationSeconds = &expirationSeconds
+	}
+	if len(o.BoundObjectKind) > 0 {
+		tokenRequest.Spec.BoundObjectRef = &authenticationv1.BoundObjectReference{
+			Kind: o.BoundObjectKind,
+			Name: o.BoundObjectName,
+			UID:  types.UID(o.BoundObjectUID),
+		}
+	}
+
+	createOptions := metav1.CreateOptions{}
+	if o.FieldManager != "" {
+		createOptions.FieldManager = o.FieldManager
+	}
+	if o.DryRunStrategy == cmdutil.DryRunServer {
+		err := o.DryRunVerifier.HasSupport(corev1.SchemeGroupVersion.WithKind("ServiceAccount"))
+		if err != nil {
+			return err
+		}
+		createOptions.DryRun = []string{metav1.DryRunAll}
+	}
+
+	tokenRequest, err := o.Client.ServiceAccounts(o.Namespace).CreateToken(context.TODO(), o.ServiceAccount, tokenRequest, createOptions)
+	if err != nil {
+		return fmt.Errorf("failed to create token request: %v", err)
+	}
+
+	if o.OutputKubeconfig != "" && o.DryRunStrategy == cmdutil.DryRunNone {
+		if tokenRequest.Status.Token == "" {
+			return fmt.Errorf("no token was returned for service account %q, cannot write kubeconfig to %q", o.ServiceAccount, o.OutputKubeconfig)
+		}
+		if err := o.writeKubeconfig(tokenRequest.Status.Token); err != nil {
+			return err
+		}
+	}
+
+	return o.PrintObj(tokenRequest)
+}
+
+// writeKubeconfig writes a kubeconfig authenticating as token against the
+// current cluster to o.OutputKubeconfig, for use as a drop-in credential by
+// CI systems and other out-of-cluster consumers of the minted token.
+func (o *CreateSecretTokenSaOptions) writeKubeconfig(token string) error {
+	const clusterName, userName, contextName = "cluster", "user", "context"
+
+	kubeconfig := clientcmdapi.Config{
+		Clusters: map[string]*clientcmdapi.Cluster{
+			clusterName: {
+				Server:                   o.RESTConfig.Host,
+				CertificateAuthority:     o.RESTConfig.CAFile,
+				CertificateAuthorityData: o.RESTConfig.CAData,
+				InsecureSkipTLSVerify:    o.RESTConfig.Insecure,
+			},
+		},
+		AuthInfos: map[string]*clientcmdapi.AuthInfo{
+			userName: {Token: token},
+		},
+		Contexts: map[string]*clientcmdapi.Context{
+			contextName: {
+				Cluster:   clusterName,
+				AuthInfo:  userName,
+				Namespace: o.Namespace,
+			},
+		},
+		CurrentContext: contextName,
+	}
+
+	if err := clientcmd.WriteToFile(kubeconfig, o.OutputKubeconfig); err != nil {
+		return fmt.Errorf("failed to write kubeconfig to %q: %v", o.OutputKubeconfig, err)
+	}
+	return nil
+}
+
+// printTokenRequestToken prints only the raw token from a TokenRequest, for use
+// in scripts that just need the bearer token and nothing else.
+func printTokenRequestToken(obj runtime.Object, out io.Writer) error {
+	tokenRequest, ok := obj.(*authenticationv1.TokenRequest)
+	if !ok {
+		return fmt.Errorf("expected *authenticationv1.TokenRequest, got %T", obj)
+	}
+	fmt.Fprintln(out, tokenRequest.Status.Token)
+	return nil
+}
+
+// printTokenRequestDefault is the default human-readable output for
+// --use-token-request: the token itself plus when it expires.
+func printTokenRequestDefault(obj runtime.Object, out io.Writer) error {
+	tokenRequest, ok := obj.(*authenticationv1.TokenRequest)
+	if !ok {
+		return fmt.Errorf("expected *authenticationv1.TokenRequest, got %T", obj)
+	}
+	fmt.Fprintln(out, tokenRequest.Status.Token)
+	fmt.Fprintf(out, "expiration: %s\n", tokenRequest.Status.ExpirationTimestamp.Time.Format(time.RFC3339))
+	return nil
+}
+
+// newSecretObjToken builds the Secret used to hold a legacy service account token.
+func newSecretObjToken(name, namespace string, secretType corev1.SecretType, annotations map[string]string) *corev1.Secret {
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "Secret",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   namespace,
+			Annotations: annotations,
+		},
+		Type: secretType,
+	}
+}